@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package elf
+
+//go:generate mockgen -destination=../../mocks/pkg/elf/elf.go -package=elf github.com/NVIDIA/dcgm-exporter/internal/pkg/elf ELF
+
+import (
+	debugelf "debug/elf"
+	"fmt"
+)
+
+// ELF is a thin wrapper around the subset of the debug/elf API that
+// prerequisites checks need. It is an interface so those checks can be
+// exercised against a generated mock instead of real binaries on disk.
+type ELF interface {
+	// Open opens the ELF file at path.
+	Open(path string) (*debugelf.File, error)
+	// SOName returns the DT_SONAME dynamic tag of f.
+	SOName(f *debugelf.File) (string, error)
+	// DynamicSymbols returns the dynamic symbol table of f.
+	DynamicSymbols(f *debugelf.File) ([]debugelf.Symbol, error)
+}
+
+// elfImpl is the ELF implementation backed by the real debug/elf package.
+type elfImpl struct{}
+
+// New returns an ELF backed by the real debug/elf package.
+func New() ELF {
+	return elfImpl{}
+}
+
+func (elfImpl) Open(path string) (*debugelf.File, error) {
+	return debugelf.Open(path)
+}
+
+func (elfImpl) SOName(f *debugelf.File) (string, error) {
+	names, err := f.DynString(debugelf.DT_SONAME)
+	if err != nil {
+		return "", err
+	}
+
+	if len(names) == 0 {
+		return "", fmt.Errorf("no DT_SONAME entry found")
+	}
+
+	return names[0], nil
+}
+
+func (elfImpl) DynamicSymbols(f *debugelf.File) ([]debugelf.Symbol, error) {
+	return f.DynamicSymbols()
+}
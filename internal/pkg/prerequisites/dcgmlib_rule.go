@@ -0,0 +1,182 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package prerequisites
+
+import (
+	debugelf "debug/elf"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pkgelf "github.com/NVIDIA/dcgm-exporter/internal/pkg/elf"
+	pkgexec "github.com/NVIDIA/dcgm-exporter/internal/pkg/exec"
+)
+
+const (
+	ldconfigPath  = "/sbin/ldconfig.real"
+	ldconfigParam = "-p"
+)
+
+var (
+	exec pkgexec.Exec = pkgexec.New()
+	elf  pkgelf.ELF   = pkgelf.New()
+)
+
+// sharedLibRule validates that a shared library required by DCGM is
+// installed, matches the architecture of the running binary, and
+// (optionally) exposes a minimum SONAME version and a set of exported
+// dynamic symbols. MinVersion and RequiredSymbols are opt-in: a zero
+// MinVersion or an empty RequiredSymbols skips the corresponding check, so
+// a bare sharedLibRule{Name: "libfoo.so.1"} behaves like a plain
+// existence/architecture check.
+type sharedLibRule struct {
+	Name            string
+	InstallHint     string
+	MinVersion      int
+	RequiredSymbols []string
+}
+
+// dcgmLibExistsRule validates that libdcgm.so.4 is installed and matches
+// the architecture of the running binary.
+type dcgmLibExistsRule struct{}
+
+func (dcgmLibExistsRule) Validate() error {
+	return sharedLibRule{
+		Name:        "libdcgm.so.4",
+		InstallHint: "Install Data Center GPU Manager (DCGM).",
+	}.Validate()
+}
+
+func (r sharedLibRule) Validate() error {
+	cmd := exec.Command(ldconfigPath, ldconfigParam)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("cannot execute %s %s command; err: %w", ldconfigPath, ldconfigParam, err)
+	}
+
+	libPath, ok := findLibPath(string(output), r.Name)
+	if !ok {
+		if r.InstallHint != "" {
+			return fmt.Errorf("the %s library was not found. %s", r.Name, r.InstallHint)
+		}
+		return fmt.Errorf("the %s library was not found", r.Name)
+	}
+
+	self, err := elf.Open("/proc/self/exe")
+	if err != nil {
+		return fmt.Errorf("cannot open /proc/self/exe; err: %w", err)
+	}
+
+	libFile, err := elf.Open(libPath)
+	if err != nil {
+		return fmt.Errorf("cannot open %s; err: %w", libPath, err)
+	}
+
+	if self.Machine != libFile.Machine {
+		return fmt.Errorf("the %s library architecture mismatch with the system; wanted: %s, received: %s",
+			r.Name, self.Machine, libFile.Machine)
+	}
+
+	if r.MinVersion > 0 {
+		if err := r.validateMinVersion(libFile); err != nil {
+			return err
+		}
+	}
+
+	if len(r.RequiredSymbols) > 0 {
+		if err := r.validateRequiredSymbols(libFile); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r sharedLibRule) validateMinVersion(libFile *debugelf.File) error {
+	soname, err := elf.SOName(libFile)
+	if err != nil {
+		return fmt.Errorf("cannot read SONAME of the %s library; err: %w", r.Name, err)
+	}
+
+	version, err := sonameVersion(soname)
+	if err != nil {
+		return fmt.Errorf("cannot parse version from the %s library SONAME %q; err: %w", r.Name, soname, err)
+	}
+
+	if version < r.MinVersion {
+		return fmt.Errorf("the %s library version %d is below the required minimum version %d",
+			r.Name, version, r.MinVersion)
+	}
+
+	return nil
+}
+
+func (r sharedLibRule) validateRequiredSymbols(libFile *debugelf.File) error {
+	symbols, err := elf.DynamicSymbols(libFile)
+	if err != nil {
+		return fmt.Errorf("cannot read dynamic symbols of the %s library; err: %w", r.Name, err)
+	}
+
+	present := make(map[string]struct{}, len(symbols))
+	for _, s := range symbols {
+		present[s.Name] = struct{}{}
+	}
+
+	for _, symbol := range r.RequiredSymbols {
+		if _, ok := present[symbol]; !ok {
+			return fmt.Errorf("the %s library is missing the required symbol %q", r.Name, symbol)
+		}
+	}
+
+	return nil
+}
+
+// findLibPath scans `ldconfig -p` output for a line advertising name and
+// returns the resolved path it maps to, e.g. given:
+//
+//	libdcgm.so.4 (libc6,x86-64) => /lib/x86_64-linux-gnu/libdcgm.so.4
+//
+// findLibPath("...", "libdcgm.so.4") returns
+// ("/lib/x86_64-linux-gnu/libdcgm.so.4", true).
+func findLibPath(ldconfigOutput, name string) (string, bool) {
+	for _, line := range strings.Split(ldconfigOutput, "\n") {
+		left, path, found := strings.Cut(line, "=>")
+		if !found {
+			continue
+		}
+
+		fields := strings.Fields(left)
+		if len(fields) == 0 || fields[0] != name {
+			continue
+		}
+
+		return strings.TrimSpace(path), true
+	}
+
+	return "", false
+}
+
+// sonameVersion extracts the trailing numeric version from a SONAME such
+// as "libdcgm.so.4", returning 4.
+func sonameVersion(soname string) (int, error) {
+	idx := strings.LastIndex(soname, ".so.")
+	if idx == -1 {
+		return 0, fmt.Errorf("SONAME %q has no .so.<version> suffix", soname)
+	}
+
+	return strconv.Atoi(soname[idx+len(".so."):])
+}
@@ -208,3 +208,100 @@ func Test_dcgmLibExistsRule_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_sharedLibRule_Validate(t *testing.T) {
+	libcudaPath := "/lib/x86_64-linux-gnu/libcuda.so.1"
+
+	ldconfigOutput := `1211 libs found in cache '/etc/ld.so.cache'
+			libcuda.so.1 (libc6,x86-64) => ` + libcudaPath + `
+		Cache generated by: ldconfig (Ubuntu GLIBC 2.35-0ubuntu3.7) stable release version 2.35`
+
+	type testCase struct {
+		Name                string
+		Rule                sharedLibRule
+		ELFMockExpectations func(*gomock.Controller, *mockelf.MockELF)
+		AssertErr           func(err error)
+	}
+
+	sameArch := func(c *gomock.Controller, mockELF *mockelf.MockELF, path string) {
+		self := &debugelf.File{FileHeader: debugelf.FileHeader{Machine: debugelf.EM_X86_64}}
+		mockELF.EXPECT().Open(gomock.Eq("/proc/self/exe")).AnyTimes().Return(self, nil)
+
+		lib := &debugelf.File{FileHeader: debugelf.FileHeader{Machine: debugelf.EM_X86_64}}
+		mockELF.EXPECT().Open(gomock.Eq(path)).AnyTimes().Return(lib, nil)
+	}
+
+	testCases := []testCase{
+		{
+			Name: "required symbols all present",
+			Rule: sharedLibRule{Name: "libcuda.so.1", RequiredSymbols: []string{"cuInit", "cuDeviceGetCount"}},
+			ELFMockExpectations: func(c *gomock.Controller, mockELF *mockelf.MockELF) {
+				sameArch(c, mockELF, libcudaPath)
+				mockELF.EXPECT().DynamicSymbols(gomock.Any()).AnyTimes().Return([]debugelf.Symbol{
+					{Name: "cuInit"},
+					{Name: "cuDeviceGetCount"},
+					{Name: "cuDeviceGet"},
+				}, nil)
+			},
+			AssertErr: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			Name: "required symbol missing",
+			Rule: sharedLibRule{Name: "libcuda.so.1", RequiredSymbols: []string{"cuInit", "cuGraphCreate"}},
+			ELFMockExpectations: func(c *gomock.Controller, mockELF *mockelf.MockELF) {
+				sameArch(c, mockELF, libcudaPath)
+				mockELF.EXPECT().DynamicSymbols(gomock.Any()).AnyTimes().Return([]debugelf.Symbol{
+					{Name: "cuInit"},
+				}, nil)
+			},
+			AssertErr: func(err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, `missing the required symbol "cuGraphCreate"`)
+			},
+		},
+		{
+			Name: "SONAME version meets the minimum",
+			Rule: sharedLibRule{Name: "libcuda.so.1", MinVersion: 1},
+			ELFMockExpectations: func(c *gomock.Controller, mockELF *mockelf.MockELF) {
+				sameArch(c, mockELF, libcudaPath)
+				mockELF.EXPECT().SOName(gomock.Any()).AnyTimes().Return("libcuda.so.1", nil)
+			},
+			AssertErr: func(err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			Name: "SONAME version below the minimum",
+			Rule: sharedLibRule{Name: "libcuda.so.1", MinVersion: 2},
+			ELFMockExpectations: func(c *gomock.Controller, mockELF *mockelf.MockELF) {
+				sameArch(c, mockELF, libcudaPath)
+				mockELF.EXPECT().SOName(gomock.Any()).AnyTimes().Return("libcuda.so.1", nil)
+			},
+			AssertErr: func(err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "version 1 is below the required minimum version 2")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			executor := mockexec.NewMockExec(ctrl)
+			cmd := mockexec.NewMockCmd(ctrl)
+			cmd.EXPECT().Output().AnyTimes().Return([]byte(ldconfigOutput), nil)
+			executor.EXPECT().Command(gomock.Eq(ldconfigPath), gomock.Eq(ldconfigParam)).AnyTimes().Return(cmd)
+			exec = executor
+
+			elfreader := mockelf.NewMockELF(ctrl)
+			tc.ELFMockExpectations(ctrl, elfreader)
+			elf = elfreader
+
+			err := tc.Rule.Validate()
+			tc.AssertErr(err)
+		})
+	}
+}
@@ -20,11 +20,15 @@ package e2e
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"helm.sh/helm/v3/pkg/release"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/rest"
 	restclient "k8s.io/client-go/rest"
@@ -33,6 +37,57 @@ import (
 	"github.com/NVIDIA/dcgm-exporter/tests/e2e/internal/framework"
 )
 
+// shouldProvisionCluster stands up a throwaway cluster via
+// testContext.provisioner (one of framework.ProvisionerKind /
+// framework.ProvisionerMinikube) and points testContext.kubeconfig at it,
+// so the suite can run without a pre-existing cluster. It is a no-op, and
+// returns a nil framework.ClusterProvisioner, when testContext.provisioner
+// is empty, which is the default for CI environments with a real GPU
+// cluster already available.
+func shouldProvisionCluster(ctx context.Context) framework.ClusterProvisioner {
+	if testContext.provisioner == "" {
+		return nil
+	}
+
+	By(fmt.Sprintf("Cluster provisioning: %q started.", testContext.provisioner))
+
+	provisioner, err := framework.NewClusterProvisioner(testContext.provisioner)
+	Expect(err).ShouldNot(HaveOccurred(), "Cluster provisioning: %v", err)
+
+	kubeconfig, err := provisioner.Provision(ctx)
+	Expect(err).ShouldNot(HaveOccurred(), "Cluster provisioning: %q failed: %v", testContext.provisioner, err)
+	testContext.kubeconfig = kubeconfig
+
+	if testContext.imageRepository != "" && testContext.imageTag != "" {
+		image := fmt.Sprintf("%s:%s", testContext.imageRepository, testContext.imageTag)
+
+		err = provisioner.LoadImage(ctx, image)
+		Expect(err).ShouldNot(HaveOccurred(), "Cluster provisioning: loading image %q failed: %v", image, err)
+	}
+
+	By(fmt.Sprintf("Cluster provisioning: %q completed.", testContext.provisioner))
+
+	return provisioner
+}
+
+// shouldTeardownCluster tears down the cluster created by
+// shouldProvisionCluster. It is a no-op when provisioner is nil, i.e. when
+// the suite ran against a pre-existing cluster.
+func shouldTeardownCluster(ctx context.Context, provisioner framework.ClusterProvisioner) {
+	if provisioner == nil {
+		return
+	}
+
+	By(fmt.Sprintf("Cluster teardown: %q started.", testContext.provisioner))
+
+	if err := provisioner.Teardown(ctx); err != nil {
+		Fail(fmt.Sprintf("Cluster teardown: %q failed: %v", testContext.provisioner, err))
+		return
+	}
+
+	By(fmt.Sprintf("Cluster teardown: %q completed.", testContext.provisioner))
+}
+
 func shouldCreateK8SConfig() *restclient.Config {
 	config, err := clientcmd.BuildConfigFromFlags("", testContext.kubeconfig)
 	Expect(err).ShouldNot(HaveOccurred(), "unable to load kubeconfig from %s; err: %s",
@@ -47,14 +102,14 @@ func shouldResolvePath() {
 		"cannot resolve path to kubeconfig: %s, err: %v", testContext.kubeconfig, err)
 }
 
-func shouldCreateNamespace(ctx context.Context, kubeClient *framework.KubeClient, labels map[string]string) {
+func shouldCreateNamespace(ctx context.Context, kubeClient framework.KubeClient, labels map[string]string) {
 	By(fmt.Sprintf("Creating namespace: %q started.", testContext.namespace))
 	_, err := kubeClient.CreateNamespace(ctx, testContext.namespace, labels)
 	Expect(err).ShouldNot(HaveOccurred(), "Creating namespace: failed")
 	By(fmt.Sprintf("Creating namespace: %q completed\n", testContext.namespace))
 }
 
-func shouldCreateKubeClient(config *rest.Config) *framework.KubeClient {
+func shouldCreateKubeClient(config *rest.Config) framework.KubeClient {
 	kubeClient, err := framework.NewKubeClient(config)
 	Expect(err).ShouldNot(HaveOccurred(), "cannot create k8s client: %s", err)
 	return kubeClient
@@ -66,7 +121,7 @@ func kubeConfigShouldExists() {
 	}
 }
 
-func shouldCreateHelmClient(config *rest.Config) *framework.HelmClient {
+func shouldCreateHelmClient(config *rest.Config) framework.HelmClient {
 	helmClient, err := framework.NewHelmClient(
 		framework.HelmWithNamespace(testContext.namespace),
 		framework.HelmWithKubeConfig(config),
@@ -78,7 +133,7 @@ func shouldCreateHelmClient(config *rest.Config) *framework.HelmClient {
 	return helmClient
 }
 
-func shouldUninstallHelmChart(helmClient *framework.HelmClient, helmReleaseName string) {
+func shouldUninstallHelmChart(helmClient framework.HelmClient, helmReleaseName string) {
 	if helmClient != nil && helmReleaseName != "" {
 		By(fmt.Sprintf("Helm chart uninstall: release %q of the helm chart: %q started.",
 			helmReleaseName,
@@ -95,7 +150,40 @@ func shouldUninstallHelmChart(helmClient *framework.HelmClient, helmReleaseName
 	}
 }
 
-func shouldCleanupHelmClient(helmClient *framework.HelmClient) {
+// shouldUpgradeHelmChart upgrades an already-installed release to the
+// values supplied, refusing to proceed unless helm reports the release as
+// "deployed". This mirrors the guard used by the kbcli upgrade flow: a
+// release stuck in "failed", "pending-upgrade", "pending-install" or
+// "uninstalling" is left alone instead of being upgraded out from under a
+// half-finished operation.
+func shouldUpgradeHelmChart(helmClient framework.HelmClient, helmReleaseName string, values []string) {
+	By(fmt.Sprintf("Helm chart upgrade: release %q of the helm chart: %q started.",
+		helmReleaseName,
+		testContext.chart))
+
+	status, err := helmClient.Status(helmReleaseName)
+	Expect(err).ShouldNot(HaveOccurred(), "Helm chart upgrade: release %q status check failed: %v",
+		helmReleaseName, err)
+
+	switch status {
+	case release.StatusDeployed:
+		// safe to upgrade
+	case release.StatusFailed, release.StatusPendingUpgrade, release.StatusPendingInstall, release.StatusUninstalling:
+		Fail(fmt.Sprintf("Helm chart upgrade: release %q is in %q state; refusing to upgrade",
+			helmReleaseName, status))
+	default:
+		Fail(fmt.Sprintf("Helm chart upgrade: release %q is in unexpected state %q", helmReleaseName, status))
+	}
+
+	err = helmClient.Upgrade(helmReleaseName, values)
+	Expect(err).ShouldNot(HaveOccurred(), "Helm chart upgrade: release %q failed: %v", helmReleaseName, err)
+
+	By(fmt.Sprintf("Helm chart upgrade: release %q of the helm chart: %q completed.",
+		helmReleaseName,
+		testContext.chart))
+}
+
+func shouldCleanupHelmClient(helmClient framework.HelmClient) {
 	if helmClient != nil {
 		err := helmClient.Cleanup()
 		if err != nil {
@@ -104,7 +192,7 @@ func shouldCleanupHelmClient(helmClient *framework.HelmClient) {
 	}
 }
 
-func shouldDeleteNamespace(ctx context.Context, kubeClient *framework.KubeClient) {
+func shouldDeleteNamespace(ctx context.Context, kubeClient framework.KubeClient) {
 	By(fmt.Sprintf("Namespace deletion: %q namespace started.", testContext.namespace))
 	if kubeClient != nil {
 		err := kubeClient.DeleteNamespace(ctx, testContext.namespace)
@@ -118,7 +206,7 @@ func shouldDeleteNamespace(ctx context.Context, kubeClient *framework.KubeClient
 }
 
 func shouldCheckIfPodCreated(
-	ctx context.Context, kubeClient *framework.KubeClient, labels map[string]string,
+	ctx context.Context, kubeClient framework.KubeClient, labels map[string]string,
 ) *corev1.Pod {
 	By("Pod creation verification: started")
 
@@ -168,35 +256,56 @@ func getDefaultHelmValues() []string {
 	return values
 }
 
-func shouldCheckIfPodIsReady(ctx context.Context, kubeClient *framework.KubeClient, namespace, podName string) {
+// shouldPortForward opens a port-forward session to the exporter's metrics
+// port on pod and returns the local URL it can be scraped from together
+// with a function the caller must invoke to close the session.
+func shouldPortForward(ctx context.Context, kubeClient framework.KubeClient, pod *corev1.Pod, port int) (string, func()) {
+	By(fmt.Sprintf("Port-forward: pod %q port %d started.", pod.Name, port))
+
+	localPort, closeFn, err := kubeClient.PortForward(ctx, pod, port)
+	Expect(err).ShouldNot(HaveOccurred(), "Port-forward: pod %q port %d failed: %v", pod.Name, port, err)
+
+	By(fmt.Sprintf("Port-forward: pod %q port %d completed, forwarded to local port %d.", pod.Name, port, localPort))
+
+	return fmt.Sprintf("http://127.0.0.1:%d/metrics", localPort), closeFn
+}
+
+// shouldScrapeMetrics scrapes url and parses the response body as
+// Prometheus text-format metric families.
+func shouldScrapeMetrics(url string) map[string]*dto.MetricFamily {
+	resp, err := http.Get(url)
+	Expect(err).ShouldNot(HaveOccurred(), "Scraping metrics from %q failed: %v", url, err)
+	defer resp.Body.Close()
+
+	var parser expfmt.TextParser
+
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	Expect(err).ShouldNot(HaveOccurred(), "Parsing metrics scraped from %q failed: %v", url, err)
+
+	return families
+}
+
+func shouldCheckIfPodIsReady(ctx context.Context, kubeClient framework.KubeClient, namespace, podName string) {
+	shouldWaitForPodCondition(ctx, kubeClient, namespace, podName, framework.IsPodReady)
+}
+
+// shouldCheckIfPodIsRunning waits for podName to be scheduled and started
+// (or already completed), without requiring a true PodReady condition.
+// Batch pods, such as RestartPolicyNever CUDA workloads, never report
+// PodReady=true, so callers gating on those must use this instead of
+// shouldCheckIfPodIsReady.
+func shouldCheckIfPodIsRunning(ctx context.Context, kubeClient framework.KubeClient, namespace, podName string) {
+	shouldWaitForPodCondition(ctx, kubeClient, namespace, podName, framework.IsPodRunning)
+}
+
+func shouldWaitForPodCondition(
+	ctx context.Context, kubeClient framework.KubeClient, namespace, podName string, condition framework.PodStatusCondition,
+) {
 	By("Checking pod status: started")
-	Eventually(func(ctx context.Context) bool {
-		isReady, err := kubeClient.CheckPodStatus(ctx,
-			namespace,
-			podName,
-			func(namespace, podName string, status corev1.PodStatus) (bool, error) {
-				for _, c := range status.Conditions {
-					if c.Type != corev1.PodReady {
-						continue
-					}
-					if c.Status == corev1.ConditionTrue {
-						return true, nil
-					}
-				}
-
-				for _, c := range status.ContainerStatuses {
-					if c.State.Waiting != nil && c.State.Waiting.Reason == "CrashLoopBackOff" {
-						return false, fmt.Errorf("pod %s in namespace %s is in CrashLoopBackOff", podName, namespace)
-					}
-				}
-
-				return false, nil
-			})
-		if err != nil {
-			Fail(fmt.Sprintf("Checking pod status: Failed with error: %v", err))
-		}
 
-		return isReady
-	}).WithPolling(time.Second).Within(15 * time.Minute).WithContext(ctx).Should(BeTrue())
+	ok, err := framework.WaitForPodCondition(ctx, kubeClient, namespace, podName, condition, time.Second, 15*time.Minute)
+	Expect(err).ShouldNot(HaveOccurred(), "Checking pod status: Failed with error: %v", err)
+	Expect(ok).Should(BeTrue(), "Checking pod status: pod %s in namespace %s did not meet the expected condition", podName, namespace)
+
 	By("Checking pod status: completed")
 }
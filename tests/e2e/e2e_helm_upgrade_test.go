@@ -0,0 +1,85 @@
+//go:build e2e
+
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+
+	"github.com/NVIDIA/dcgm-exporter/tests/e2e/internal/framework"
+)
+
+// previousImageTag pins the last published image tag so the upgrade
+// scenario always exercises a real version jump instead of upgrading a
+// release onto itself.
+const previousImageTag = "3.3.5-3.4.1-ubuntu22.04"
+
+var _ = Describe("Helm chart upgrade", Ordered, func() {
+	var (
+		provisioner framework.ClusterProvisioner
+		kubeConfig  *rest.Config
+		kubeClient  framework.KubeClient
+		helmClient  framework.HelmClient
+		releaseName string
+		podLabels   map[string]string
+	)
+
+	BeforeAll(func(ctx context.Context) {
+		provisioner = shouldProvisionCluster(ctx)
+
+		shouldResolvePath()
+		kubeConfigShouldExists()
+
+		kubeConfig = shouldCreateK8SConfig()
+		kubeClient = shouldCreateKubeClient(kubeConfig)
+		helmClient = shouldCreateHelmClient(kubeConfig)
+
+		releaseName = "dcgm-exporter-upgrade"
+		podLabels = map[string]string{"app.kubernetes.io/instance": releaseName}
+
+		shouldCreateNamespace(ctx, kubeClient, nil)
+	})
+
+	AfterAll(func(ctx context.Context) {
+		shouldUninstallHelmChart(helmClient, releaseName)
+		shouldDeleteNamespace(ctx, kubeClient)
+		shouldCleanupHelmClient(helmClient)
+		shouldTeardownCluster(ctx, provisioner)
+	})
+
+	It("installs the previous release and upgrades it to the current image", func(ctx context.Context) {
+		previousValues := append(getDefaultHelmValues(), fmt.Sprintf("image.tag=%s", previousImageTag))
+
+		By(fmt.Sprintf("Helm chart install: release %q at image tag %q started.", releaseName, previousImageTag))
+		err := helmClient.Install(releaseName, previousValues)
+		Expect(err).ShouldNot(HaveOccurred(), "Helm chart install: release %q failed", releaseName)
+		By(fmt.Sprintf("Helm chart install: release %q at image tag %q completed.", releaseName, previousImageTag))
+
+		pod := shouldCheckIfPodCreated(ctx, kubeClient, podLabels)
+		shouldCheckIfPodIsReady(ctx, kubeClient, pod.Namespace, pod.Name)
+
+		shouldUpgradeHelmChart(helmClient, releaseName, getDefaultHelmValues())
+
+		pod = shouldCheckIfPodCreated(ctx, kubeClient, podLabels)
+		shouldCheckIfPodIsReady(ctx, kubeClient, pod.Namespace, pod.Name)
+	})
+})
@@ -0,0 +1,183 @@
+//go:build e2e
+
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/NVIDIA/dcgm-exporter/tests/e2e/internal/framework"
+)
+
+const metricsPort = 9400
+
+// expectedMetricFamilies is the golden set of metric families the exporter
+// must always publish, regardless of which GPU metrics are enabled.
+var expectedMetricFamilies = []string{
+	"DCGM_FI_DEV_GPU_UTIL",
+	"DCGM_FI_DEV_MEM_COPY_UTIL",
+	"DCGM_FI_DEV_FB_USED",
+}
+
+// baseMetricLabels are the labels every sample of a GPU metric must carry
+// regardless of whether the GPU is mapped to a workload pod.
+var baseMetricLabels = []string{"gpu", "UUID", "Hostname"}
+
+// podMetricLabels are the additional labels dcgm-exporter attaches once a
+// GPU is mapped to a kubernetes pod.
+var podMetricLabels = []string{"pod", "namespace", "container"}
+
+var _ = Describe("Metrics scraping", Ordered, func() {
+	var (
+		provisioner framework.ClusterProvisioner
+		kubeConfig  *rest.Config
+		kubeClient  framework.KubeClient
+		helmClient  framework.HelmClient
+		releaseName string
+		podLabels   map[string]string
+		pod         *corev1.Pod
+	)
+
+	BeforeAll(func(ctx context.Context) {
+		provisioner = shouldProvisionCluster(ctx)
+
+		shouldResolvePath()
+		kubeConfigShouldExists()
+
+		kubeConfig = shouldCreateK8SConfig()
+		kubeClient = shouldCreateKubeClient(kubeConfig)
+		helmClient = shouldCreateHelmClient(kubeConfig)
+
+		releaseName = "dcgm-exporter-metrics"
+		podLabels = map[string]string{"app.kubernetes.io/instance": releaseName}
+
+		shouldCreateNamespace(ctx, kubeClient, nil)
+
+		err := helmClient.Install(releaseName, getDefaultHelmValues())
+		Expect(err).ShouldNot(HaveOccurred(), "Helm chart install: release %q failed", releaseName)
+
+		pod = shouldCheckIfPodCreated(ctx, kubeClient, podLabels)
+		shouldCheckIfPodIsReady(ctx, kubeClient, pod.Namespace, pod.Name)
+	})
+
+	AfterAll(func(ctx context.Context) {
+		shouldUninstallHelmChart(helmClient, releaseName)
+		shouldDeleteNamespace(ctx, kubeClient)
+		shouldCleanupHelmClient(helmClient)
+		shouldTeardownCluster(ctx, provisioner)
+	})
+
+	It("exposes the golden set of metric families with the expected labels", func(ctx context.Context) {
+		url, closePortForward := shouldPortForward(ctx, kubeClient, pod, metricsPort)
+		defer closePortForward()
+
+		families := shouldScrapeMetrics(url)
+
+		for _, name := range expectedMetricFamilies {
+			Expect(families).To(HaveKey(name), "metric family %q was not scraped", name)
+
+			for _, metric := range families[name].GetMetric() {
+				var labelNames []string
+				for _, label := range metric.GetLabel() {
+					labelNames = append(labelNames, label.GetName())
+				}
+
+				Expect(labelNames).To(ContainElements(baseMetricLabels),
+					"metric family %q is missing one of the expected labels %v, got %v",
+					name, baseMetricLabels, labelNames)
+			}
+		}
+	})
+
+	When("a CUDA workload is running", func() {
+		It("reports DCGM_FI_DEV_GPU_UTIL samples labeled with the workload's pod and namespace", func(ctx context.Context) {
+			workloadLabels := map[string]string{"app.kubernetes.io/name": "dcgm-exporter-e2e-workload"}
+			workloadPod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dcgm-exporter-e2e-workload",
+					Namespace: testContext.namespace,
+					Labels:    workloadLabels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    "workload",
+							Image:   testContext.workloadImage,
+							Command: testContext.workloadCommand,
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									"nvidia.com/gpu": resource.MustParse("1"),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			By(fmt.Sprintf("Workload pod creation: %q started.", workloadPod.Name))
+			_, err := kubeClient.CreatePod(ctx, workloadPod)
+			Expect(err).ShouldNot(HaveOccurred(), "Workload pod creation: %q failed", workloadPod.Name)
+			By(fmt.Sprintf("Workload pod creation: %q completed.", workloadPod.Name))
+
+			shouldCheckIfPodIsRunning(ctx, kubeClient, workloadPod.Namespace, workloadPod.Name)
+
+			url, closePortForward := shouldPortForward(ctx, kubeClient, pod, metricsPort)
+			defer closePortForward()
+
+			var matchedLabelNames []string
+
+			Eventually(func(ctx context.Context) bool {
+				families := shouldScrapeMetrics(url)
+
+				util, ok := families["DCGM_FI_DEV_GPU_UTIL"]
+				if !ok {
+					return false
+				}
+
+				for _, metric := range util.GetMetric() {
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == "pod" && label.GetValue() == workloadPod.Name {
+							matchedLabelNames = nil
+							for _, l := range metric.GetLabel() {
+								matchedLabelNames = append(matchedLabelNames, l.GetName())
+							}
+
+							return true
+						}
+					}
+				}
+
+				return false
+			}).WithPolling(5*time.Second).Within(2*time.Minute).WithContext(ctx).Should(BeTrue(),
+				"no DCGM_FI_DEV_GPU_UTIL sample labeled with pod %q appeared within the bounded window", workloadPod.Name)
+
+			Expect(matchedLabelNames).To(ContainElements(podMetricLabels),
+				"DCGM_FI_DEV_GPU_UTIL sample for pod %q is missing one of the expected labels %v, got %v",
+				workloadPod.Name, podMetricLabels, matchedLabelNames)
+		})
+	})
+})
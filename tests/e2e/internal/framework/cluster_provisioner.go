@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"fmt"
+)
+
+// Supported values for testContext.provisioner.
+const (
+	ProvisionerKind     = "kind"
+	ProvisionerMinikube = "minikube"
+)
+
+// clusterName is the name given to every ephemeral cluster/profile the e2e
+// suite provisions, so stray clusters left behind by a crashed run are easy
+// to spot and clean up by hand.
+const clusterName = "dcgm-exporter-e2e"
+
+// ClusterProvisioner stands up a throwaway Kubernetes cluster for the e2e
+// suite to run against, so contributors without a pre-existing (GPU)
+// cluster can still run `make e2e` locally. It is an interface so the
+// choice of tool (kind, minikube, ...) is a detail scenario code does not
+// need to know about.
+type ClusterProvisioner interface {
+	// Provision creates the cluster and returns the path to a kubeconfig
+	// that can reach it.
+	Provision(ctx context.Context) (kubeconfigPath string, err error)
+	// LoadImage makes a locally built image available to the cluster
+	// without needing to push it to a registry first.
+	LoadImage(ctx context.Context, image string) error
+	// Teardown destroys the cluster created by Provision.
+	Teardown(ctx context.Context) error
+}
+
+// NewClusterProvisioner returns the ClusterProvisioner registered under
+// name, matching one of the Provisioner* constants.
+func NewClusterProvisioner(name string) (ClusterProvisioner, error) {
+	switch name {
+	case ProvisionerKind:
+		return &KindProvisioner{clusterName: clusterName}, nil
+	case ProvisionerMinikube:
+		return &MinikubeProvisioner{profile: clusterName}, nil
+	default:
+		return nil, fmt.Errorf("unsupported cluster provisioner %q; supported values: %q, %q",
+			name, ProvisionerKind, ProvisionerMinikube)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+//go:generate mockgen -destination=../../../../internal/mocks/tests/e2e/framework/helmclient.go -package=framework github.com/NVIDIA/dcgm-exporter/tests/e2e/internal/framework HelmClient
+
+import (
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/strvals"
+	"k8s.io/client-go/rest"
+)
+
+// parseValues turns "key=value" helm --set-style strings into the nested
+// map action.Install/Upgrade expect.
+func parseValues(values []string) (map[string]interface{}, error) {
+	vals := map[string]interface{}{}
+	for _, v := range values {
+		if err := strvals.ParseInto(v, vals); err != nil {
+			return nil, fmt.Errorf("cannot parse helm value %q: %w", v, err)
+		}
+	}
+
+	return vals, nil
+}
+
+// HelmClient manages the lifecycle of a single helm chart against a
+// cluster. It is an interface so scenario helpers can be exercised against
+// a generated mock instead of a live Tiller-less helm action config.
+type HelmClient interface {
+	Install(releaseName string, values []string) error
+	Upgrade(releaseName string, values []string) error
+	Status(releaseName string) (release.Status, error)
+	Uninstall(releaseName string) error
+	Cleanup() error
+}
+
+// HelmOption configures a HelmClientImpl built by NewHelmClient.
+type HelmOption func(*HelmClientImpl)
+
+// HelmWithNamespace sets the namespace the chart is installed into.
+func HelmWithNamespace(namespace string) HelmOption {
+	return func(h *HelmClientImpl) { h.namespace = namespace }
+}
+
+// HelmWithKubeConfig points the helm action config at the given cluster.
+func HelmWithKubeConfig(config *rest.Config) HelmOption {
+	return func(h *HelmClientImpl) { h.restConfig = config }
+}
+
+// HelmWithChart sets the path to the chart being installed/upgraded.
+func HelmWithChart(chartPath string) HelmOption {
+	return func(h *HelmClientImpl) { h.chartPath = chartPath }
+}
+
+// HelmClientImpl is the HelmClient implementation backed by the helm
+// action package.
+type HelmClientImpl struct {
+	namespace  string
+	restConfig *rest.Config
+	chartPath  string
+	cfg        *action.Configuration
+}
+
+// NewHelmClient builds a HelmClient configured with the supplied options.
+func NewHelmClient(opts ...HelmOption) (HelmClient, error) {
+	h := &HelmClientImpl{}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	cfg := new(action.Configuration)
+	if err := cfg.Init(cli.New().RESTClientGetter(), h.namespace, "", func(string, ...interface{}) {}); err != nil {
+		return nil, fmt.Errorf("cannot initialize helm action configuration: %w", err)
+	}
+	h.cfg = cfg
+
+	return h, nil
+}
+
+func (h *HelmClientImpl) loadChart() (*chart.Chart, error) {
+	c, err := loader.Load(h.chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load chart %s: %w", h.chartPath, err)
+	}
+
+	return c, nil
+}
+
+func (h *HelmClientImpl) Install(releaseName string, values []string) error {
+	c, err := h.loadChart()
+	if err != nil {
+		return err
+	}
+
+	vals, err := parseValues(values)
+	if err != nil {
+		return err
+	}
+
+	install := action.NewInstall(h.cfg)
+	install.ReleaseName = releaseName
+	install.Namespace = h.namespace
+
+	if _, err := install.Run(c, vals); err != nil {
+		return fmt.Errorf("cannot install release %s: %w", releaseName, err)
+	}
+
+	return nil
+}
+
+func (h *HelmClientImpl) Upgrade(releaseName string, values []string) error {
+	c, err := h.loadChart()
+	if err != nil {
+		return err
+	}
+
+	vals, err := parseValues(values)
+	if err != nil {
+		return err
+	}
+
+	upgrade := action.NewUpgrade(h.cfg)
+	upgrade.Namespace = h.namespace
+
+	if _, err := upgrade.Run(releaseName, c, vals); err != nil {
+		return fmt.Errorf("cannot upgrade release %s: %w", releaseName, err)
+	}
+
+	return nil
+}
+
+func (h *HelmClientImpl) Status(releaseName string) (release.Status, error) {
+	status := action.NewStatus(h.cfg)
+
+	rel, err := status.Run(releaseName)
+	if err != nil {
+		return "", fmt.Errorf("cannot get status of release %s: %w", releaseName, err)
+	}
+
+	return rel.Info.Status, nil
+}
+
+func (h *HelmClientImpl) Uninstall(releaseName string) error {
+	uninstall := action.NewUninstall(h.cfg)
+
+	if _, err := uninstall.Run(releaseName); err != nil {
+		return fmt.Errorf("cannot uninstall release %s: %w", releaseName, err)
+	}
+
+	return nil
+}
+
+func (h *HelmClientImpl) Cleanup() error {
+	return nil
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// KindProvisioner provisions an ephemeral cluster using kind
+// (https://kind.sigs.k8s.io). It shells out to the kind CLI rather than
+// vendoring kind as a library so the e2e suite tracks whatever kind
+// version the contributor has installed.
+type KindProvisioner struct {
+	clusterName string
+}
+
+func (p *KindProvisioner) Provision(ctx context.Context) (string, error) {
+	kubeconfig, err := os.CreateTemp("", "dcgm-exporter-e2e-kind-*.kubeconfig")
+	if err != nil {
+		return "", fmt.Errorf("cannot create temporary kubeconfig: %w", err)
+	}
+	kubeconfig.Close()
+
+	cmd := exec.CommandContext(ctx, "kind", "create", "cluster",
+		"--name", p.clusterName,
+		"--kubeconfig", kubeconfig.Name(),
+		"--wait", "5m")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("cannot create kind cluster %s: %w", p.clusterName, err)
+	}
+
+	return kubeconfig.Name(), nil
+}
+
+func (p *KindProvisioner) LoadImage(ctx context.Context, image string) error {
+	cmd := exec.CommandContext(ctx, "kind", "load", "docker-image", image, "--name", p.clusterName)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cannot load image %s into kind cluster %s: %w", image, p.clusterName, err)
+	}
+
+	return nil
+}
+
+func (p *KindProvisioner) Teardown(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "kind", "delete", "cluster", "--name", p.clusterName)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cannot delete kind cluster %s: %w", p.clusterName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+//go:generate mockgen -destination=../../../../internal/mocks/tests/e2e/framework/kubeclient.go -package=framework github.com/NVIDIA/dcgm-exporter/tests/e2e/internal/framework KubeClient
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// PodStatusCondition evaluates the status of a single pod and reports
+// whether the condition the caller is waiting for has been met. Returning
+// an error aborts the poll immediately instead of retrying.
+type PodStatusCondition func(namespace, podName string, status corev1.PodStatus) (bool, error)
+
+// KubeClient is a thin wrapper around the subset of the Kubernetes API the
+// e2e suite needs. It is an interface so scenario helpers can be exercised
+// against a generated mock instead of a live cluster.
+type KubeClient interface {
+	CreateNamespace(ctx context.Context, name string, labels map[string]string) (*corev1.Namespace, error)
+	DeleteNamespace(ctx context.Context, name string) error
+	CreatePod(ctx context.Context, pod *corev1.Pod) (*corev1.Pod, error)
+	GetPodsByLabel(ctx context.Context, namespace string, labels map[string]string) ([]corev1.Pod, error)
+	CheckPodStatus(ctx context.Context, namespace, podName string, condition PodStatusCondition) (bool, error)
+	// PortForward opens a port-forward session to a single container port of
+	// pod and returns the ephemeral local port it is bound to along with a
+	// function that tears the session down. Callers must invoke the
+	// returned function once they are done scraping.
+	PortForward(ctx context.Context, pod *corev1.Pod, containerPort int) (int, func(), error)
+}
+
+// KubeClientImpl is the KubeClient implementation backed by a real
+// Kubernetes API server.
+type KubeClientImpl struct {
+	clientset  kubernetes.Interface
+	restConfig *rest.Config
+}
+
+// NewKubeClient builds a KubeClient from a rest.Config.
+func NewKubeClient(config *rest.Config) (KubeClient, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create kubernetes clientset: %w", err)
+	}
+
+	return &KubeClientImpl{clientset: clientset, restConfig: config}, nil
+}
+
+func (k *KubeClientImpl) CreateNamespace(
+	ctx context.Context, name string, labels map[string]string,
+) (*corev1.Namespace, error) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+
+	return k.clientset.CoreV1().Namespaces().Create(ctx, ns, metav1.CreateOptions{})
+}
+
+func (k *KubeClientImpl) DeleteNamespace(ctx context.Context, name string) error {
+	return k.clientset.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+func (k *KubeClientImpl) CreatePod(ctx context.Context, pod *corev1.Pod) (*corev1.Pod, error) {
+	created, err := k.clientset.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create pod %s in namespace %s: %w", pod.Name, pod.Namespace, err)
+	}
+
+	return created, nil
+}
+
+func (k *KubeClientImpl) GetPodsByLabel(
+	ctx context.Context, namespace string, labels map[string]string,
+) ([]corev1.Pod, error) {
+	pods, err := k.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(labels)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list pods in namespace %s: %w", namespace, err)
+	}
+
+	return pods.Items, nil
+}
+
+func (k *KubeClientImpl) CheckPodStatus(
+	ctx context.Context, namespace, podName string, condition PodStatusCondition,
+) (bool, error) {
+	pod, err := k.clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return false, fmt.Errorf("cannot get pod %s in namespace %s: %w", podName, namespace, err)
+	}
+
+	return condition(namespace, podName, pod.Status)
+}
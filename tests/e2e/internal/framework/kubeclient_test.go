@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+
+	mockframework "github.com/NVIDIA/dcgm-exporter/internal/mocks/tests/e2e/framework"
+)
+
+func Test_WaitForPodCondition(t *testing.T) {
+	const (
+		namespace = "test-namespace"
+		podName   = "test-pod"
+		interval  = time.Millisecond
+		timeout   = 50 * time.Millisecond
+	)
+
+	alwaysReady := func(string, string, corev1.PodStatus) (bool, error) { return true, nil }
+	alwaysPending := func(string, string, corev1.PodStatus) (bool, error) { return false, nil }
+
+	testCases := []struct {
+		Name          string
+		SetupKubeMock func(*gomock.Controller, *mockframework.MockKubeClient)
+		Condition     PodStatusCondition
+		Ready         bool
+		AssertErr     func(t *testing.T, err error)
+	}{
+		{
+			Name: "condition satisfied on the first poll",
+			SetupKubeMock: func(ctrl *gomock.Controller, kubeClient *mockframework.MockKubeClient) {
+				kubeClient.EXPECT().
+					CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).
+					Times(1).
+					Return(true, nil)
+			},
+			Condition: alwaysReady,
+			Ready:     true,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name: "condition satisfied after a few retries",
+			SetupKubeMock: func(ctrl *gomock.Controller, kubeClient *mockframework.MockKubeClient) {
+				gomock.InOrder(
+					kubeClient.EXPECT().CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).Return(false, nil),
+					kubeClient.EXPECT().CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).Return(false, nil),
+					kubeClient.EXPECT().CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).Return(true, nil),
+				)
+			},
+			Condition: alwaysReady,
+			Ready:     true,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name: "condition returns an error, polling stops immediately",
+			SetupKubeMock: func(ctrl *gomock.Controller, kubeClient *mockframework.MockKubeClient) {
+				kubeClient.EXPECT().
+					CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).
+					Times(1).
+					Return(false, errors.New("pod is in CrashLoopBackOff"))
+			},
+			Condition: alwaysPending,
+			Ready:     false,
+			AssertErr: func(t *testing.T, err error) { require.ErrorContains(t, err, "CrashLoopBackOff") },
+		},
+		{
+			Name: "condition never satisfied, returns false once the timeout elapses",
+			SetupKubeMock: func(ctrl *gomock.Controller, kubeClient *mockframework.MockKubeClient) {
+				kubeClient.EXPECT().
+					CheckPodStatus(gomock.Any(), namespace, podName, gomock.Any()).
+					MinTimes(1).
+					Return(false, nil)
+			},
+			Condition: alwaysPending,
+			Ready:     false,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			kubeClient := mockframework.NewMockKubeClient(ctrl)
+			tc.SetupKubeMock(ctrl, kubeClient)
+
+			ready, err := WaitForPodCondition(context.Background(), kubeClient, namespace, podName, tc.Condition, interval, timeout)
+			require.Equal(t, tc.Ready, ready)
+			tc.AssertErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// MinikubeProvisioner provisions an ephemeral cluster using minikube
+// (https://minikube.sigs.k8s.io). It shells out to the minikube CLI rather
+// than vendoring it as a library so the e2e suite tracks whatever
+// minikube version the contributor has installed.
+type MinikubeProvisioner struct {
+	profile string
+}
+
+func (p *MinikubeProvisioner) Provision(ctx context.Context) (string, error) {
+	kubeconfig, err := os.CreateTemp("", "dcgm-exporter-e2e-minikube-*.kubeconfig")
+	if err != nil {
+		return "", fmt.Errorf("cannot create temporary kubeconfig: %w", err)
+	}
+	kubeconfig.Close()
+
+	// minikube honors KUBECONFIG like kubectl does, so pointing it at our
+	// temporary file makes it write (and merge) the cluster context there
+	// instead of the contributor's default kubeconfig.
+	cmd := exec.CommandContext(ctx, "minikube", "start", "-p", p.profile)
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+kubeconfig.Name())
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("cannot start minikube profile %s: %w", p.profile, err)
+	}
+
+	return kubeconfig.Name(), nil
+}
+
+func (p *MinikubeProvisioner) LoadImage(ctx context.Context, image string) error {
+	cmd := exec.CommandContext(ctx, "minikube", "image", "load", image, "-p", p.profile)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cannot load image %s into minikube profile %s: %w", image, p.profile, err)
+	}
+
+	return nil
+}
+
+func (p *MinikubeProvisioner) Teardown(ctx context.Context) error {
+	cmd := exec.CommandContext(ctx, "minikube", "delete", "-p", p.profile)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cannot delete minikube profile %s: %w", p.profile, err)
+	}
+
+	return nil
+}
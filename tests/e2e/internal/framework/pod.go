@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// IsPodReady reports whether status carries a true PodReady condition. It
+// returns an error instead of retrying when a container is wedged in
+// CrashLoopBackOff, since waiting out the polling window for a pod that is
+// crash-looping only slows the suite down for no benefit.
+func IsPodReady(namespace, podName string, status corev1.PodStatus) (bool, error) {
+	for _, c := range status.Conditions {
+		if c.Type != corev1.PodReady {
+			continue
+		}
+		if c.Status == corev1.ConditionTrue {
+			return true, nil
+		}
+	}
+
+	return false, crashLoopBackOffErr(namespace, podName, status)
+}
+
+// IsPodRunning reports whether status has progressed past Pending, i.e. the
+// pod has been scheduled and its containers have started (Running) or it
+// has already run to completion (Succeeded). Unlike IsPodReady, this does
+// not wait for a readiness probe to pass, so it is the right condition for
+// batch pods, such as RestartPolicyNever CUDA workloads, that never report
+// PodReady=true. It returns an error instead of retrying when a container
+// is wedged in CrashLoopBackOff, for the same reason as IsPodReady.
+func IsPodRunning(namespace, podName string, status corev1.PodStatus) (bool, error) {
+	switch status.Phase {
+	case corev1.PodRunning, corev1.PodSucceeded:
+		return true, nil
+	}
+
+	return false, crashLoopBackOffErr(namespace, podName, status)
+}
+
+// crashLoopBackOffErr returns an error if status reports a container stuck
+// in CrashLoopBackOff, and nil otherwise.
+func crashLoopBackOffErr(namespace, podName string, status corev1.PodStatus) error {
+	for _, c := range status.ContainerStatuses {
+		if c.State.Waiting != nil && c.State.Waiting.Reason == "CrashLoopBackOff" {
+			return fmt.Errorf("pod %s in namespace %s is in CrashLoopBackOff", podName, namespace)
+		}
+	}
+
+	return nil
+}
+
+// WaitForPodCondition polls kubeClient every interval until condition is
+// met for the named pod, condition returns an error, ctx is done, or
+// timeout elapses, whichever happens first. It is the fixed-interval retry
+// logic behind the e2e suite's "wait for pod" helpers, factored out so it can be
+// exercised with a mock KubeClient instead of a live cluster.
+func WaitForPodCondition(
+	ctx context.Context, kubeClient KubeClient, namespace, podName string,
+	condition PodStatusCondition, interval, timeout time.Duration,
+) (bool, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		ok, err := kubeClient.CheckPodStatus(ctx, namespace, podName, condition)
+		if err != nil {
+			return false, err
+		}
+
+		if ok {
+			return true, nil
+		}
+
+		if time.Now().After(deadline) {
+			return false, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
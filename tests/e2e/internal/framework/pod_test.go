@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_IsPodReady(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Status    corev1.PodStatus
+		Ready     bool
+		AssertErr func(t *testing.T, err error)
+	}{
+		{
+			Name: "pod ready",
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+				},
+			},
+			Ready:     true,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name: "pod not ready, no error",
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+				},
+			},
+			Ready:     false,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name:      "pod has no conditions yet",
+			Status:    corev1.PodStatus{},
+			Ready:     false,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name: "container stuck in CrashLoopBackOff",
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+					},
+				},
+			},
+			Ready: false,
+			AssertErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "CrashLoopBackOff")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			ready, err := IsPodReady("test-namespace", "test-pod", tc.Status)
+			require.Equal(t, tc.Ready, ready)
+			tc.AssertErr(t, err)
+		})
+	}
+}
+
+func Test_IsPodRunning(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Status    corev1.PodStatus
+		Running   bool
+		AssertErr func(t *testing.T, err error)
+	}{
+		{
+			Name:      "pod pending",
+			Status:    corev1.PodStatus{Phase: corev1.PodPending},
+			Running:   false,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name:      "pod running",
+			Status:    corev1.PodStatus{Phase: corev1.PodRunning},
+			Running:   true,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name:      "pod succeeded",
+			Status:    corev1.PodStatus{Phase: corev1.PodSucceeded},
+			Running:   true,
+			AssertErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			Name: "container stuck in CrashLoopBackOff",
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						State: corev1.ContainerState{
+							Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+					},
+				},
+			},
+			Running: false,
+			AssertErr: func(t *testing.T, err error) {
+				require.Error(t, err)
+				require.ErrorContains(t, err, "CrashLoopBackOff")
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			running, err := IsPodRunning("test-namespace", "test-pod", tc.Status)
+			require.Equal(t, tc.Running, running)
+			tc.AssertErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2024, NVIDIA CORPORATION.  All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package framework
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// PortForward opens an SPDY port-forward session from an ephemeral local
+// port to containerPort on pod, so the e2e suite can reach the exporter's
+// metrics endpoint without requiring it to be exposed via a Service.
+func (k *KubeClientImpl) PortForward(
+	ctx context.Context, pod *corev1.Pod, containerPort int,
+) (int, func(), error) {
+	transport, upgrader, err := spdy.RoundTripperFor(k.restConfig)
+	if err != nil {
+		return 0, nil, fmt.Errorf("cannot build SPDY round tripper: %w", err)
+	}
+
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/portforward", pod.Namespace, pod.Name)
+	serverURL := url.URL{
+		Scheme: "https",
+		Host:   strings.TrimPrefix(k.restConfig.Host, "https://"),
+		Path:   path,
+	}
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, &serverURL)
+
+	readyCh := make(chan struct{})
+	stopCh := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	fw, err := portforward.New(dialer, []string{fmt.Sprintf("0:%d", containerPort)}, stopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return 0, nil, fmt.Errorf("cannot create port-forwarder for pod %s: %w", pod.Name, err)
+	}
+
+	go func() { errCh <- fw.ForwardPorts() }()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		return 0, nil, fmt.Errorf("port-forward to pod %s failed: %w", pod.Name, err)
+	case <-ctx.Done():
+		close(stopCh)
+		return 0, nil, ctx.Err()
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil {
+		close(stopCh)
+		return 0, nil, fmt.Errorf("cannot read forwarded ports for pod %s: %w", pod.Name, err)
+	}
+
+	return int(ports[0].Local), func() { close(stopCh) }, nil
+}